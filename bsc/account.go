@@ -0,0 +1,200 @@
+// Package bsc provides thin helpers around go-ethereum for driving the
+// EIP-7702 demo against BNB Smart Chain: account/key management, building
+// transact options, and sending EIP-7702 set-code transactions.
+package bsc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Account wraps a Signer together with the client used to read chain state
+// and broadcast transactions on its behalf. All signing goes through
+// Signer, so swapping ECDSASigner for an external.Signer or keystore.Signer
+// is enough to move an Account's key material off this process.
+type Account struct {
+	Client Client
+	Signer Signer
+	Addr   *common.Address
+}
+
+// NewAccount derives an Account from a hex-encoded private key (with or
+// without the "0x" prefix), signing in-process via ECDSASigner.
+func NewAccount(client Client, privKeyHex string) (*Account, error) {
+	privKeyHex = strings.TrimPrefix(privKeyHex, "0x")
+	privKey, err := crypto.HexToECDSA(privKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	return NewAccountWithSigner(client, NewECDSASigner(privKey)), nil
+}
+
+// NewAccountWithSigner builds an Account whose transactions and
+// authorizations are signed by signer, e.g. an external.Signer talking to
+// Clef or a keystore.Signer backed by an encrypted key file.
+func NewAccountWithSigner(client Client, signer Signer) *Account {
+	addr := signer.Address()
+	return &Account{
+		Client: client,
+		Signer: signer,
+		Addr:   &addr,
+	}
+}
+
+// SignEIP702Auth signs an EIP-7702 SetCodeAuthorization designating delegate
+// as the code for this account. A nil nonce or chainID is resolved against
+// the connected client (current account nonce / chain ID respectively).
+func (a *Account) SignEIP702Auth(delegate common.Address, nonce *uint64, chainID *big.Int) *types.SetCodeAuthorization {
+	if chainID == nil {
+		id, err := a.Client.ChainID(context.Background())
+		if err != nil {
+			return nil
+		}
+		chainID = id
+	}
+	if nonce == nil {
+		n, err := a.Client.PendingNonceAt(context.Background(), *a.Addr)
+		if err != nil {
+			return nil
+		}
+		nonce = &n
+	}
+
+	auth := types.SetCodeAuthorization{
+		ChainID: *uint256FromBig(chainID),
+		Address: delegate,
+		Nonce:   *nonce,
+	}
+	if err := a.Signer.SignAuthorization(&auth); err != nil {
+		return nil
+	}
+	return &auth
+}
+
+// BuildTransactOpts builds bind.TransactOpts for this account. A nonce of 0
+// is treated as "use the account's current pending nonce"; a nil gasPrice
+// defers to the client's suggested gas price. Signing is delegated to
+// a.Signer, so the returned opts work the same whether the account's key is
+// held in-process, behind Clef, or in a keystore.
+func (a *Account) BuildTransactOpts(nonce int64, gasPrice *big.Int, gasLimit uint64) (*bind.TransactOpts, error) {
+	chainID, err := a.Client.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+
+	opts := &bind.TransactOpts{
+		From:    *a.Addr,
+		Context: context.Background(),
+		Signer: func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return a.Signer.SignTx(tx, chainID)
+		},
+	}
+
+	if nonce > 0 {
+		opts.Nonce = big.NewInt(nonce)
+	} else {
+		pendingNonce, err := a.Client.PendingNonceAt(context.Background(), *a.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("get pending nonce: %w", err)
+		}
+		opts.Nonce = big.NewInt(int64(pendingNonce))
+	}
+
+	if gasPrice == nil {
+		gasPrice, err = a.Client.SuggestGasPrice(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("suggest gas price: %w", err)
+		}
+	}
+	opts.GasPrice = gasPrice
+	opts.GasLimit = gasLimit
+
+	return opts, nil
+}
+
+// SendEIP7702Tx builds, signs and broadcasts a SetCodeTx carrying authList;
+// with an empty authList it sends a plain dynamic-fee transaction instead.
+// A nil to targets the sender itself, which is the common shape for
+// Joe-sponsors-Bob style authorization transactions.
+func (a *Account) SendEIP7702Tx(opts *bind.TransactOpts, to *common.Address, authList []types.SetCodeAuthorization, value *big.Int, data []byte) (*common.Hash, error) {
+	if to == nil {
+		to = a.Addr
+	}
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	chainID, err := a.Client.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+
+	tip, err := a.Client.SuggestGasTipCap(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("suggest gas tip cap: %w", err)
+	}
+	feeCap := new(big.Int).Add(opts.GasPrice, tip)
+
+	var tx *types.Transaction
+	if len(authList) == 0 {
+		// A SetCodeTx with an empty authorization list is invalid, so with
+		// nothing to authorize this sends a plain dynamic-fee transaction.
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     opts.Nonce.Uint64(),
+			GasTipCap: tip,
+			GasFeeCap: feeCap,
+			Gas:       opts.GasLimit,
+			To:        to,
+			Value:     value,
+			Data:      data,
+		})
+	} else {
+		tx = types.NewTx(&types.SetCodeTx{
+			ChainID:   uint256FromBig(chainID),
+			Nonce:     opts.Nonce.Uint64(),
+			GasTipCap: uint256FromBig(tip),
+			GasFeeCap: uint256FromBig(feeCap),
+			Gas:       opts.GasLimit,
+			To:        *to,
+			Value:     uint256FromBig(value),
+			Data:      data,
+			AuthList:  authList,
+		})
+	}
+
+	signedTx, err := a.Signer.SignTx(tx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("sign set code tx: %w", err)
+	}
+
+	if err := a.Client.SendTransaction(context.Background(), signedTx); err != nil {
+		return nil, fmt.Errorf("send set code tx: %w", err)
+	}
+
+	hash := signedTx.Hash()
+	return &hash, nil
+}
+
+// GetReceipt polls for txHash's receipt until it is mined or timeoutSec
+// elapses, returning nil on timeout. Errors from the client are not
+// returned; polling continues until the deadline.
+func (a *Account) GetReceipt(txHash common.Hash, timeoutSec int) *types.Receipt {
+	deadline := time.Now().Add(time.Duration(timeoutSec) * time.Second)
+	for time.Now().Before(deadline) {
+		receipt, err := a.Client.TransactionReceipt(context.Background(), txHash)
+		if err == nil && receipt != nil {
+			return receipt
+		}
+		time.Sleep(2 * time.Second)
+	}
+	return nil
+}
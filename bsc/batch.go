@@ -0,0 +1,53 @@
+package bsc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnb-chain/eip7702-demo/contracts/batcher"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Call is a single (target, value, calldata) sub-call to run as part of a
+// batch executed by the account's EIP-7702 delegate.
+type Call = batcher.Call
+
+// SendBatchViaDelegate ABI-encodes calls for the Batcher delegate's self-only
+// execute entrypoint and sends it as a normal transaction to the account
+// itself. It assumes the account has already authorized a Batcher delegate
+// (see bsc.Delegation.Install); the chain applies that delegate's code to
+// address(this) == a.Addr for the duration of the call, so sub-calls revert
+// atomically on any failure.
+func (a *Account) SendBatchViaDelegate(opts *bind.TransactOpts, calls []Call) (*types.Transaction, error) {
+	data, err := batcher.PackExecute(calls)
+	if err != nil {
+		return nil, fmt.Errorf("pack execute batch: %w", err)
+	}
+
+	chainID, err := a.Client.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     opts.Nonce.Uint64(),
+		GasTipCap: opts.GasPrice,
+		GasFeeCap: opts.GasPrice,
+		Gas:       opts.GasLimit,
+		To:        a.Addr,
+		Data:      data,
+	})
+
+	signedTx, err := opts.Signer(*a.Addr, tx)
+	if err != nil {
+		return nil, fmt.Errorf("sign batch tx: %w", err)
+	}
+
+	if err := a.Client.SendTransaction(context.Background(), signedTx); err != nil {
+		return nil, fmt.Errorf("send batch tx: %w", err)
+	}
+
+	return signedTx, nil
+}
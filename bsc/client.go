@@ -0,0 +1,21 @@
+package bsc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Client is the subset of *ethclient.Client's behavior Account needs: enough
+// to build transact options, broadcast transactions and poll receipts/code.
+// It is satisfied by *ethclient.Client itself and by
+// ethclient/simulated.Client, so Account can be driven against a simulated
+// backend in tests without go-ethereum's simulated package needing to leak
+// its concrete client type.
+type Client interface {
+	bind.ContractBackend
+	bind.DeployBackend
+
+	ChainID(ctx context.Context) (*big.Int, error)
+}
@@ -0,0 +1,182 @@
+package bsc
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bnb-chain/eip7702-demo/contracts/V2router"
+	"github.com/bnb-chain/eip7702-demo/contracts/bep20"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"gopkg.in/yaml.v3"
+)
+
+// ChainConfig describes how to reach one EVM chain and the well-known
+// contract addresses the demo needs on it.
+type ChainConfig struct {
+	ChainID uint64            `json:"chainId" yaml:"chainId"`
+	Name    string            `json:"name" yaml:"name"`
+	RPC     string            `json:"rpc" yaml:"rpc"`
+	Router  string            `json:"router" yaml:"router"`
+	Tokens  map[string]string `json:"tokens" yaml:"tokens"`
+}
+
+// Config is the top-level shape of a chains.yaml/chains.json file.
+type Config struct {
+	Chains []ChainConfig `json:"chains" yaml:"chains"`
+}
+
+// LoadConfig reads a chain registry from a YAML or JSON file, chosen by its
+// extension.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config %s: %w", path, err)
+	}
+
+	var cfg Config
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("parse json config: %w", err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("parse yaml config: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported config extension %q (want .json, .yaml or .yml)", ext)
+	}
+	return &cfg, nil
+}
+
+// ContractMaker resolves clients and well-known contract addresses for any
+// chain listed in its Config, dialing each RPC endpoint lazily and caching
+// the result so the same 7702 flow can run against BSC mainnet, opBNB,
+// Ethereum Sepolia or a local Anvil node by passing that chain's ID, with
+// RPCs and addresses coming only from the config. The demo in main.go picks
+// its chain with a compile-time ChainID constant.
+type ContractMaker struct {
+	mu      sync.Mutex
+	chains  map[uint64]ChainConfig
+	clients map[uint64]*ethclient.Client
+}
+
+// NewContractMaker builds a ContractMaker from an already-loaded Config.
+func NewContractMaker(cfg *Config) (*ContractMaker, error) {
+	chains := make(map[uint64]ChainConfig, len(cfg.Chains))
+	for _, c := range cfg.Chains {
+		if c.ChainID == 0 {
+			return nil, fmt.Errorf("chain %q: chainId is required", c.Name)
+		}
+		chains[c.ChainID] = c
+	}
+	return &ContractMaker{
+		chains:  chains,
+		clients: make(map[uint64]*ethclient.Client),
+	}, nil
+}
+
+// NewContractMakerFromFile is a convenience wrapper around LoadConfig + NewContractMaker.
+func NewContractMakerFromFile(path string) (*ContractMaker, error) {
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewContractMaker(cfg)
+}
+
+func (m *ContractMaker) chain(chainID uint64) (ChainConfig, error) {
+	c, ok := m.chains[chainID]
+	if !ok {
+		return ChainConfig{}, fmt.Errorf("no chain registered for chain id %d", chainID)
+	}
+	return c, nil
+}
+
+// Client returns the cached *ethclient.Client for chainID, dialing it on
+// first use.
+func (m *ContractMaker) Client(chainID uint64) (*ethclient.Client, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if client, ok := m.clients[chainID]; ok {
+		return client, nil
+	}
+
+	c, err := m.chain(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ethclient.Dial(c.RPC)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s (chain %d): %w", c.RPC, chainID, err)
+	}
+	m.clients[chainID] = client
+	return client, nil
+}
+
+// TokenAddress resolves a token symbol (e.g. "USDT", "WBNB") registered for chainID.
+func (m *ContractMaker) TokenAddress(chainID uint64, symbol string) (common.Address, error) {
+	c, err := m.chain(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, ok := c.Tokens[symbol]
+	if !ok {
+		return common.Address{}, fmt.Errorf("chain %d has no token registered for symbol %q", chainID, symbol)
+	}
+	return common.HexToAddress(addr), nil
+}
+
+// RouterAddress resolves the swap router address registered for chainID.
+func (m *ContractMaker) RouterAddress(chainID uint64) (common.Address, error) {
+	c, err := m.chain(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if c.Router == "" {
+		return common.Address{}, fmt.Errorf("chain %d has no router registered", chainID)
+	}
+	return common.HexToAddress(c.Router), nil
+}
+
+// NewRouter binds the registered router contract on chainID.
+func (m *ContractMaker) NewRouter(chainID uint64) (*V2router.SimpleRouter, error) {
+	client, err := m.Client(chainID)
+	if err != nil {
+		return nil, err
+	}
+	routerAddr, err := m.RouterAddress(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return V2router.NewSimpleRouter(routerAddr, client)
+}
+
+// NewBEP20 binds the registered token contract for symbol on chainID.
+func (m *ContractMaker) NewBEP20(chainID uint64, symbol string) (*bep20.Bep20, error) {
+	client, err := m.Client(chainID)
+	if err != nil {
+		return nil, err
+	}
+	tokenAddr, err := m.TokenAddress(chainID, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return bep20.NewBep20(tokenAddr, client)
+}
+
+// Close closes every client dialed so far.
+func (m *ContractMaker) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, client := range m.clients {
+		client.Close()
+	}
+}
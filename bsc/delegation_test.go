@@ -0,0 +1,168 @@
+package bsc
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+func newTestBackend(t *testing.T) (*simulated.Backend, *Account) {
+	t.Helper()
+
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	addr := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		addr: {Balance: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000))},
+	})
+
+	account := NewAccountWithSigner(backend.Client(), NewECDSASigner(privKey))
+	return backend, account
+}
+
+// TestDecodeDelegationDesignator checks decodeDelegationDesignator, which
+// decodes the code main.go reads inline from Bob's account, against the
+// EIP-7702 0xef0100 || address format.
+func TestDecodeDelegationDesignator(t *testing.T) {
+	delegate := common.HexToAddress("0x00000000000000000000000000000000001234")
+	code := append(append([]byte{}, delegationDesignatorPrefix[:]...), delegate.Bytes()...)
+
+	got, ok, err := decodeDelegationDesignator(code)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if !ok {
+		t.Fatalf("expected code to be recognized as a delegation designator")
+	}
+	if got != delegate {
+		t.Fatalf("got delegate %s, want %s", got.Hex(), delegate.Hex())
+	}
+
+	if _, ok, _ := decodeDelegationDesignator(nil); ok {
+		t.Fatalf("empty code must not be reported as a delegation designator")
+	}
+	if _, _, err := decodeDelegationDesignator([]byte{0x60, 0x00}); err == nil {
+		t.Fatalf("expected an error for non-designator code")
+	}
+}
+
+func TestDelegationInstallAndCurrent(t *testing.T) {
+	backend, account := newTestBackend(t)
+	defer backend.Close()
+
+	delegate := common.HexToAddress("0x00000000000000000000000000000000005678")
+	delegation := NewDelegation(account)
+
+	if _, err := delegation.Install(delegate); err != nil {
+		t.Fatalf("install: %v", err)
+	}
+	backend.Commit()
+
+	got, ok, err := delegation.Current()
+	if err != nil {
+		t.Fatalf("current: %v", err)
+	}
+	if !ok {
+		t.Fatalf("expected a delegation to be installed")
+	}
+	if got != delegate {
+		t.Fatalf("got delegate %s, want %s", got.Hex(), delegate.Hex())
+	}
+}
+
+func TestDelegationRevokeClearsCode(t *testing.T) {
+	backend, account := newTestBackend(t)
+	defer backend.Close()
+
+	delegate := common.HexToAddress("0x00000000000000000000000000000000005678")
+	delegation := NewDelegation(account)
+
+	if _, err := delegation.Install(delegate); err != nil {
+		t.Fatalf("install: %v", err)
+	}
+	backend.Commit()
+
+	if _, err := delegation.Revoke(); err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+	backend.Commit()
+
+	_, ok, err := delegation.Current()
+	if err != nil {
+		t.Fatalf("current: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected revocation to clear the delegation")
+	}
+}
+
+// TestDelegationConcurrentAuthorizationsRespectNonce fires two Install calls
+// from separate goroutines at the same time and checks that they do not both
+// claim the same nonce. go-ethereum's txpool only ever allows one
+// in-flight SetCodeTx per delegated account, so firing two Installs at the
+// same account before either is mined always has exactly one accepted and
+// the other rejected by the pool — this checks that the rejection is clean
+// (the accepted install claims its own nonce, the rejected one leaves no
+// trace) rather than both landing on the same nonce.
+func TestDelegationConcurrentAuthorizationsRespectNonce(t *testing.T) {
+	backend, account := newTestBackend(t)
+	defer backend.Close()
+
+	delegation := NewDelegation(account)
+
+	delegateA := common.HexToAddress("0x00000000000000000000000000000000000aaa")
+	delegateB := common.HexToAddress("0x00000000000000000000000000000000000bbb")
+
+	startNonce, err := account.Client.PendingNonceAt(t.Context(), *account.Addr)
+	if err != nil {
+		t.Fatalf("pending nonce: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, errs[0] = delegation.Install(delegateA)
+	}()
+	go func() {
+		defer wg.Done()
+		_, errs[1] = delegation.Install(delegateB)
+	}()
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("expected exactly one of two concurrent installs to be accepted (the pool allows only one in-flight SetCodeTx per delegated account), got %d succeeding: %v", succeeded, errs)
+	}
+	backend.Commit()
+
+	endNonce, err := account.Client.PendingNonceAt(t.Context(), *account.Addr)
+	if err != nil {
+		t.Fatalf("pending nonce: %v", err)
+	}
+	if endNonce != startNonce+1 {
+		t.Fatalf("got nonce %d after one accepted install, want %d (the rejected install must not consume a nonce)", endNonce, startNonce+1)
+	}
+
+	got, ok, err := delegation.Current()
+	if err != nil {
+		t.Fatalf("current: %v", err)
+	}
+	if !ok || (got != delegateA && got != delegateB) {
+		t.Fatalf("expected the accepted install's delegate to be installed, got %s ok=%v", got.Hex(), ok)
+	}
+}
@@ -0,0 +1,29 @@
+package bsc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bnb-chain/eip7702-demo/compiler"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployContract deploys a compiler.Contract (as returned by
+// compiler.CompileSolidity) with the given constructor args, letting
+// contributors iterate on a delegate's Solidity source without hand-writing
+// or regenerating an abigen binding first.
+func (a *Account) DeployContract(opts *bind.TransactOpts, contract *compiler.Contract, args ...interface{}) (common.Address, *types.Transaction, error) {
+	parsed, err := abi.JSON(strings.NewReader(contract.ABI))
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("parse contract abi: %w", err)
+	}
+
+	addr, tx, _, err := bind.DeployContract(opts, parsed, common.FromHex(contract.Bin), a.Client, args...)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("deploy contract: %w", err)
+	}
+	return addr, tx, nil
+}
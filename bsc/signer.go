@@ -0,0 +1,68 @@
+package bsc
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer abstracts away how a private key is held so that SetCodeAuthorization
+// and transaction signing can be backed by an in-process key, a remote
+// Clef/JSON-RPC signer, or an encrypted keystore, without the rest of the
+// package caring which. Account drives every signature (authorizations and
+// transactions alike) through whichever Signer it was built with: NewAccount
+// uses ECDSASigner for the common raw-key case, while NewAccountWithSigner
+// accepts an external.Signer or keystore.Signer to keep key material off
+// this process entirely.
+type Signer interface {
+	// Address returns the account this signer signs on behalf of.
+	Address() common.Address
+
+	// SignAuthorization fills in auth's V/R/S fields with a signature over
+	// its (chainID, address, nonce) tuple, as defined by EIP-7702.
+	SignAuthorization(auth *types.SetCodeAuthorization) error
+
+	// SignTx returns a signed copy of tx for the given chain.
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// ECDSASigner is the in-process Signer backed by a raw private key, i.e. the
+// key material bsc.Account has always held directly.
+type ECDSASigner struct {
+	PrivKey *ecdsa.PrivateKey
+	Addr    common.Address
+}
+
+// NewECDSASigner wraps an in-process private key as a Signer.
+func NewECDSASigner(privKey *ecdsa.PrivateKey) *ECDSASigner {
+	return &ECDSASigner{
+		PrivKey: privKey,
+		Addr:    crypto.PubkeyToAddress(privKey.PublicKey),
+	}
+}
+
+func (s *ECDSASigner) Address() common.Address {
+	return s.Addr
+}
+
+func (s *ECDSASigner) SignAuthorization(auth *types.SetCodeAuthorization) error {
+	signed, err := types.SignSetCode(s.PrivKey, *auth)
+	if err != nil {
+		return fmt.Errorf("sign authorization: %w", err)
+	}
+	*auth = signed
+	return nil
+}
+
+func (s *ECDSASigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	signedTx, err := types.SignTx(tx, signer, s.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign tx: %w", err)
+	}
+	return signedTx, nil
+}
@@ -0,0 +1,70 @@
+package bsc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+func TestECDSASignerSignAuthorization(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	signer := NewECDSASigner(privKey)
+
+	delegate := crypto.PubkeyToAddress(privKey.PublicKey)
+	chainID, _ := uint256.FromBig(big.NewInt(97))
+	auth := types.SetCodeAuthorization{
+		ChainID: *chainID,
+		Address: delegate,
+		Nonce:   0,
+	}
+
+	if err := signer.SignAuthorization(&auth); err != nil {
+		t.Fatalf("sign authorization: %v", err)
+	}
+
+	recovered, err := auth.Authority()
+	if err != nil {
+		t.Fatalf("recover authority: %v", err)
+	}
+	if recovered != signer.Address() {
+		t.Fatalf("recovered authority %s, want %s", recovered.Hex(), signer.Address().Hex())
+	}
+}
+
+func TestECDSASignerSignTx(t *testing.T) {
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	signer := NewECDSASigner(privKey)
+
+	chainID := big.NewInt(97)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     0,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Gas:       21000,
+		To:        &signer.Addr,
+		Value:     big.NewInt(0),
+	})
+
+	signedTx, err := signer.SignTx(tx, chainID)
+	if err != nil {
+		t.Fatalf("sign tx: %v", err)
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		t.Fatalf("recover sender: %v", err)
+	}
+	if sender != signer.Address() {
+		t.Fatalf("recovered sender %s, want %s", sender.Hex(), signer.Address().Hex())
+	}
+}
@@ -0,0 +1,14 @@
+package bsc
+
+import (
+	"math/big"
+
+	"github.com/holiman/uint256"
+)
+
+// uint256FromBig converts a *big.Int into the *uint256.Int type used by
+// go-ethereum's EIP-7702 transaction and authorization structs.
+func uint256FromBig(v *big.Int) *uint256.Int {
+	u, _ := uint256.FromBig(v)
+	return u
+}
@@ -0,0 +1,37 @@
+// This file is a binding for a Uniswap-V2-style router contract, hand-
+// maintained in abigen's style.
+
+package V2router
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const SimpleRouterABI = `[{"constant":false,"inputs":[{"name":"amountIn","type":"uint256"},{"name":"amountOutMin","type":"uint256"},{"name":"path","type":"address[]"},{"name":"to","type":"address"},{"name":"deadline","type":"uint256"}],"name":"swapExactTokensForTokens","outputs":[{"name":"amounts","type":"uint256[]"}],"stateMutability":"nonpayable","type":"function"}]`
+
+// SimpleRouter is a Go binding around a Uniswap-V2-style
+// router contract.
+type SimpleRouter struct {
+	contract *bind.BoundContract
+}
+
+// NewSimpleRouter binds SimpleRouter to a deployed router contract at addr.
+func NewSimpleRouter(addr common.Address, backend bind.ContractBackend) (*SimpleRouter, error) {
+	parsed, err := abi.JSON(strings.NewReader(SimpleRouterABI))
+	if err != nil {
+		return nil, err
+	}
+	return &SimpleRouter{contract: bind.NewBoundContract(addr, parsed, backend, backend, backend)}, nil
+}
+
+// SwapExactTokensForTokens swaps an exact amountIn of path[0] for at least
+// amountOutMin of path[len(path)-1], sending the output to `to`.
+func (r *SimpleRouter) SwapExactTokensForTokens(opts *bind.TransactOpts, amountIn, amountOutMin *big.Int, path []common.Address, to common.Address, deadline *big.Int) (*types.Transaction, error) {
+	return r.contract.Transact(opts, "swapExactTokensForTokens", amountIn, amountOutMin, path, to, deadline)
+}
@@ -0,0 +1,94 @@
+// This file is a binding for contracts/batcher/Batcher.sol, hand-maintained
+// in abigen's style. The `compiler` package compiles Batcher.sol at runtime
+// but does not generate this binding.
+
+package batcher
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Call mirrors the Solidity Batcher.Call struct: a single sub-call target,
+// value and calldata within a batch.
+type Call struct {
+	Target common.Address
+	Value  *big.Int
+	Data   []byte
+}
+
+const BatcherABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"internalType":"struct Batcher.Call[]","name":"calls","type":"tuple[]"}],"name":"execute","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"internalType":"struct Batcher.Call[]","name":"calls","type":"tuple[]"},{"internalType":"bytes","name":"signature","type":"bytes"}],"name":"executeWithSig","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"nonce","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+// Batcher is a Go binding around the Batcher delegate contract.
+type Batcher struct {
+	BatcherCaller
+	BatcherTransactor
+}
+
+// BatcherCaller implements the read-only side of the Batcher binding.
+type BatcherCaller struct {
+	contract *bind.BoundContract
+}
+
+// BatcherTransactor implements the write side of the Batcher binding.
+type BatcherTransactor struct {
+	contract *bind.BoundContract
+}
+
+// NewBatcher binds Batcher to a deployed contract at addr.
+func NewBatcher(addr common.Address, backend bind.ContractBackend) (*Batcher, error) {
+	parsed, err := abi.JSON(strings.NewReader(BatcherABI))
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(addr, parsed, backend, backend, backend)
+	return &Batcher{
+		BatcherCaller:     BatcherCaller{contract: contract},
+		BatcherTransactor: BatcherTransactor{contract: contract},
+	}, nil
+}
+
+// Nonce returns the delegate's current executeWithSig replay-protection nonce.
+func (b *BatcherCaller) Nonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := b.contract.Call(opts, &out, "nonce")
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// Execute calls the self-only execute(calls) entrypoint.
+func (b *BatcherTransactor) Execute(opts *bind.TransactOpts, calls []Call) (*types.Transaction, error) {
+	return b.contract.Transact(opts, "execute", calls)
+}
+
+// ExecuteWithSig calls the relayer-facing executeWithSig(calls, signature) entrypoint.
+func (b *BatcherTransactor) ExecuteWithSig(opts *bind.TransactOpts, calls []Call, signature []byte) (*types.Transaction, error) {
+	return b.contract.Transact(opts, "executeWithSig", calls, signature)
+}
+
+// PackExecute ABI-encodes a call to execute(calls) without needing a bound
+// contract instance, for callers (like bsc.Account.SendBatchViaDelegate) that
+// send the resulting calldata through their own transaction path.
+func PackExecute(calls []Call) ([]byte, error) {
+	parsed, err := abi.JSON(strings.NewReader(BatcherABI))
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("execute", calls)
+}
+
+// PackExecuteWithSig ABI-encodes a call to executeWithSig(calls, signature).
+func PackExecuteWithSig(calls []Call, signature []byte) ([]byte, error) {
+	parsed, err := abi.JSON(strings.NewReader(BatcherABI))
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("executeWithSig", calls, signature)
+}
@@ -0,0 +1,67 @@
+// This file is a binding for a standard BEP20/ERC20 token, hand-maintained
+// in abigen's style.
+
+package bep20
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const Bep20ABI = `[{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"stateMutability":"view","type":"function"},{"constant":true,"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},{"constant":false,"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},{"constant":false,"inputs":[{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+
+// Bep20 is a Go binding around a BEP20 token contract.
+type Bep20 struct {
+	contract *bind.BoundContract
+}
+
+// NewBep20 binds Bep20 to a deployed token contract at addr.
+func NewBep20(addr common.Address, backend bind.ContractBackend) (*Bep20, error) {
+	parsed, err := abi.JSON(strings.NewReader(Bep20ABI))
+	if err != nil {
+		return nil, err
+	}
+	return &Bep20{contract: bind.NewBoundContract(addr, parsed, backend, backend, backend)}, nil
+}
+
+// BalanceOf returns the token balance of account.
+func (t *Bep20) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	if err := t.contract.Call(opts, &out, "balanceOf", account); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// Decimals returns the number of decimals the token uses.
+func (t *Bep20) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	if err := t.contract.Call(opts, &out, "decimals"); err != nil {
+		return 0, err
+	}
+	return *abi.ConvertType(out[0], new(uint8)).(*uint8), nil
+}
+
+// Symbol returns the token's ticker symbol.
+func (t *Bep20) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	if err := t.contract.Call(opts, &out, "symbol"); err != nil {
+		return "", err
+	}
+	return *abi.ConvertType(out[0], new(string)).(*string), nil
+}
+
+// Approve approves spender to transfer up to amount on the caller's behalf.
+func (t *Bep20) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "approve", spender, amount)
+}
+
+// Transfer sends amount of the token to recipient.
+func (t *Bep20) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return t.contract.Transact(opts, "transfer", recipient, amount)
+}
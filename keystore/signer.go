@@ -0,0 +1,81 @@
+// Package keystore backs bsc.Signer with a go-ethereum encrypted keystore
+// account instead of a raw in-process private key.
+package keystore
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/bnb-chain/eip7702-demo/bsc"
+	"github.com/ethereum/go-ethereum/accounts"
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// setCodeAuthMagic is the EIP-7702 domain separator prepended to the RLP
+// encoding of (chainId, address, nonce) before hashing for signing.
+const setCodeAuthMagic = 0x05
+
+// Signer implements bsc.Signer against an account held in a go-ethereum
+// keystore directory, unlocked with a passphrase for each signing
+// operation rather than kept unlocked in memory.
+type Signer struct {
+	ks         *gethkeystore.KeyStore
+	account    accounts.Account
+	passphrase string
+}
+
+// NewSigner opens (or attaches to) the keystore at keystoreDir and binds the
+// signer to addr, which must already have a key file in that directory.
+func NewSigner(keystoreDir string, addr common.Address, passphrase string) (*Signer, error) {
+	ks := gethkeystore.NewKeyStore(keystoreDir, gethkeystore.StandardScryptN, gethkeystore.StandardScryptP)
+	account := accounts.Account{Address: addr}
+	found, err := ks.Find(account)
+	if err != nil {
+		return nil, fmt.Errorf("find keystore account %s: %w", addr.Hex(), err)
+	}
+	return &Signer{ks: ks, account: found, passphrase: passphrase}, nil
+}
+
+func (s *Signer) Address() common.Address {
+	return s.account.Address
+}
+
+// SignAuthorization signs the EIP-7702 (chainId, address, nonce) tuple via
+// the keystore's raw-hash signing path and fills auth's V/R/S fields from
+// the resulting 65-byte signature.
+func (s *Signer) SignAuthorization(auth *types.SetCodeAuthorization) error {
+	preimage, err := rlp.EncodeToBytes([]interface{}{
+		auth.ChainID.ToBig(),
+		auth.Address,
+		auth.Nonce,
+	})
+	if err != nil {
+		return fmt.Errorf("encode authorization preimage: %w", err)
+	}
+	hash := crypto.Keccak256(append([]byte{setCodeAuthMagic}, preimage...))
+
+	sig, err := s.ks.SignHashWithPassphrase(s.account, s.passphrase, hash)
+	if err != nil {
+		return fmt.Errorf("sign authorization hash: %w", err)
+	}
+
+	auth.R.SetBytes(sig[0:32])
+	auth.S.SetBytes(sig[32:64])
+	auth.V = sig[64]
+	return nil
+}
+
+// SignTx signs tx via the keystore's passphrase-gated transaction signing.
+func (s *Signer) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signedTx, err := s.ks.SignTxWithPassphrase(s.account, s.passphrase, tx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("sign tx: %w", err)
+	}
+	return signedTx, nil
+}
+
+var _ bsc.Signer = (*Signer)(nil)
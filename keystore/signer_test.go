@@ -0,0 +1,88 @@
+package keystore
+
+import (
+	"math/big"
+	"testing"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+const testPassphrase = "test-passphrase"
+
+func TestSignerSignAuthorization(t *testing.T) {
+	signer := newSignerForKeystoreDir(t)
+
+	chainID, _ := uint256.FromBig(big.NewInt(97))
+	auth := types.SetCodeAuthorization{
+		ChainID: *chainID,
+		Address: signer.Address(),
+		Nonce:   0,
+	}
+
+	if err := signer.SignAuthorization(&auth); err != nil {
+		t.Fatalf("sign authorization: %v", err)
+	}
+
+	recovered, err := auth.Authority()
+	if err != nil {
+		t.Fatalf("recover authority: %v", err)
+	}
+	if recovered != signer.Address() {
+		t.Fatalf("recovered authority %s, want %s", recovered.Hex(), signer.Address().Hex())
+	}
+}
+
+func TestSignerSignTx(t *testing.T) {
+	signer := newSignerForKeystoreDir(t)
+
+	chainID := big.NewInt(97)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     0,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Gas:       21000,
+		Value:     big.NewInt(0),
+	})
+
+	signedTx, err := signer.SignTx(tx, chainID)
+	if err != nil {
+		t.Fatalf("sign tx: %v", err)
+	}
+
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		t.Fatalf("recover sender: %v", err)
+	}
+	if sender != signer.Address() {
+		t.Fatalf("recovered sender %s, want %s", sender.Hex(), signer.Address().Hex())
+	}
+}
+
+// newSignerForKeystoreDir builds a Signer against a fresh keystore
+// directory holding a single freshly-imported key, so each test runs
+// against its own on-disk keystore.
+func newSignerForKeystoreDir(t *testing.T) *Signer {
+	t.Helper()
+
+	dir := t.TempDir()
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	ks := gethkeystore.NewKeyStore(dir, gethkeystore.LightScryptN, gethkeystore.LightScryptP)
+	account, err := ks.ImportECDSA(privKey, testPassphrase)
+	if err != nil {
+		t.Fatalf("import key: %v", err)
+	}
+
+	signer, err := NewSigner(dir, account.Address, testPassphrase)
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+	return signer
+}
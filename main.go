@@ -8,27 +8,29 @@ import (
 	"time"
 
 	"github.com/bnb-chain/eip7702-demo/bsc"
+	"github.com/bnb-chain/eip7702-demo/compiler"
 	"github.com/bnb-chain/eip7702-demo/contracts/V2router"
 	"github.com/bnb-chain/eip7702-demo/contracts/bep20"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
-const (
-	BSC_TESTNET_RPC = "https://bsc-testnet.bnbchain.org"
-	ROUTER_ADDRESS  = "0x66c488c48fF2CB17450391D24b923A92e5f6da5C"
-	USDT_ADDRESS    = "0x11952129E0583F4d1DF5E93384Be07C405C11D6b"
-	WBNB_ADDRESS    = "0xae13d989daC2f0dEbFf460aC112a837C89BAa7cd"
-)
+// ChainID is which registered chain (see configs/chains.yaml) the demo runs
+// against. Switch networks by changing this value, not by editing RPCs or
+// addresses below.
+const ChainID = 97 // bsc-testnet
 
 func main() {
-	// Connect to BSC testnet
-	client, err := ethclient.Dial(BSC_TESTNET_RPC)
+	maker, err := bsc.NewContractMakerFromFile("configs/chains.yaml")
+	if err != nil {
+		log.Fatalf("Failed to load chain registry: %v", err)
+	}
+	defer maker.Close()
+
+	client, err := maker.Client(ChainID)
 	if err != nil {
-		log.Fatalf("Failed to connect to BSC: %v", err)
+		log.Fatalf("Failed to connect to chain %d: %v", ChainID, err)
 	}
-	defer client.Close()
 
 	Bob, err := bsc.NewAccount(client, "2292e6d240d784706c1d46dedd26d511e919837f043be64cde9ee234534bb387")
 	if err != nil {
@@ -42,10 +44,19 @@ func main() {
 	}
 	log.Printf("Joe's address: %s", Joe.Addr.Hex())
 
-	// Setup contract addresses
-	routerAddr := common.HexToAddress(ROUTER_ADDRESS)
-	usdtAddr := common.HexToAddress(USDT_ADDRESS)
-	wbnbAddr := common.HexToAddress(WBNB_ADDRESS)
+	// Resolve contract addresses from the chain registry
+	routerAddr, err := maker.RouterAddress(ChainID)
+	if err != nil {
+		log.Fatalf("Failed to resolve router address: %v", err)
+	}
+	usdtAddr, err := maker.TokenAddress(ChainID, "USDT")
+	if err != nil {
+		log.Fatalf("Failed to resolve USDT address: %v", err)
+	}
+	wbnbAddr, err := maker.TokenAddress(ChainID, "WBNB")
+	if err != nil {
+		log.Fatalf("Failed to resolve WBNB address: %v", err)
+	}
 
 	// Bob sign authorizes to the router contract
 	auth1 := Bob.SignEIP702Auth(routerAddr, nil, nil)
@@ -137,6 +148,48 @@ func main() {
 		log.Fatalf("Failed to get Bob's final WBNB balance: %v", err)
 	}
 	log.Printf("Bob's final WBNB balance: %s", ToStringByPrecise(bobWBNBBalFinal, 18))
+
+	// Compile the batcher delegate from source and re-authorize Bob to it,
+	// rather than hardcoding its deployed address, so contributors can edit
+	// contracts/batcher/Batcher.sol and try their change without touching
+	// any Go code.
+	batcherContract, err := compiler.CompileSolidity("contracts/batcher/Batcher.sol")
+	if err != nil {
+		log.Fatalf("Failed to compile Batcher.sol: %v", err)
+	}
+
+	deployOpts, err := Joe.BuildTransactOpts(0, nil, 3e6)
+	if err != nil {
+		log.Fatalf("Failed to build transaction options: %v", err)
+	}
+	batcherAddr, deployTx, err := Joe.DeployContract(deployOpts, batcherContract)
+	if err != nil {
+		log.Fatalf("Failed to deploy Batcher delegate: %v", err)
+	}
+	log.Printf("Batcher delegate deployed at %s (tx %s)", batcherAddr.Hex(), deployTx.Hash().Hex())
+
+	deployReceipt := Joe.GetReceipt(deployTx.Hash(), 120)
+	if deployReceipt == nil || deployReceipt.Status != 1 {
+		log.Fatalf("Batcher delegate deployment failed or timed out")
+	}
+
+	delegation := bsc.NewDelegation(Bob)
+	installTxHash, err := delegation.Install(batcherAddr)
+	if err != nil {
+		log.Fatalf("Failed to install Batcher delegate: %v", err)
+	}
+	log.Printf("Batcher delegate install tx: %s", installTxHash.Hex())
+
+	installReceipt := Bob.GetReceipt(installTxHash, 120)
+	if installReceipt == nil || installReceipt.Status != 1 {
+		log.Fatalf("Batcher delegate install failed or timed out")
+	}
+
+	current, ok, err := delegation.Current()
+	if err != nil || !ok || current != batcherAddr {
+		log.Fatalf("Delegation sanity check failed: current=%s ok=%v err=%v", current.Hex(), ok, err)
+	}
+	log.Printf("Bob's code now delegates to the freshly compiled Batcher at %s", current.Hex())
 }
 
 func ToIntByPrecise(value string, precise int64) *big.Int {
@@ -0,0 +1,115 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/eip7702-demo/bsc"
+	"github.com/bnb-chain/eip7702-demo/contracts/bep20"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FeePolicy decides how (or whether) the relayer charges a sender for
+// sponsoring their UserOp, and returns the list of calls to execute through
+// the delegate. Because op.Signature is signed by the sender over op.Calls
+// before the relayer ever sees it (see Batcher.executeWithSig), a policy
+// must not add or remove calls — any call it spliced in after the fact
+// would no longer be covered by the signature and the whole batch would be
+// rejected on chain. A reimbursement policy instead validates that the
+// sender already included an acceptable fee-transfer call in their batch.
+type FeePolicy interface {
+	Apply(ctx context.Context, op UserOp) ([]bsc.Call, error)
+}
+
+// FreePolicy sponsors every UserOp at no charge to the sender, the policy
+// the demo's Joe-pays-for-Bob flow uses today.
+type FreePolicy struct{}
+
+func (FreePolicy) Apply(_ context.Context, op UserOp) ([]bsc.Call, error) {
+	return op.Calls, nil
+}
+
+// PriceOracle quotes a fee, in the reimbursement token's smallest unit, for
+// sponsoring gasLimit gas worth of execution.
+type PriceOracle interface {
+	Quote(ctx context.Context, gasLimit uint64) (*big.Int, error)
+}
+
+// ERC20ReimbursementPolicy charges the sender in an ERC20/BEP20 token by
+// requiring their batch to already include a transfer(relayer, fee) call
+// priced at least as high as Oracle quotes, and otherwise leaves the batch
+// untouched. It cannot add that call itself: op.Signature is signed over
+// op.Calls before the relayer sees it, so a call spliced in afterwards
+// would no longer be covered by the signature and executeWithSig would
+// reject the whole batch (see Batcher._isValidSignature). Since the
+// delegate runs as the sender's own account, the transfer moves the
+// sender's own balance directly, with no prior approve() needed.
+type ERC20ReimbursementPolicy struct {
+	Token    common.Address
+	Relayer  common.Address
+	Oracle   PriceOracle
+	GasLimit uint64
+}
+
+func (p ERC20ReimbursementPolicy) Apply(ctx context.Context, op UserOp) ([]bsc.Call, error) {
+	fee, err := p.Oracle.Quote(ctx, p.GasLimit)
+	if err != nil {
+		return nil, fmt.Errorf("quote fee: %w", err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(bep20.Bep20ABI))
+	if err != nil {
+		return nil, fmt.Errorf("parse token abi: %w", err)
+	}
+	transferMethod := parsed.Methods["transfer"]
+
+	for _, call := range op.Calls {
+		if call.Target != p.Token || len(call.Data) < 4 || !bytes.Equal(call.Data[:4], transferMethod.ID) {
+			continue
+		}
+		args, err := transferMethod.Inputs.Unpack(call.Data[4:])
+		if err != nil || len(args) != 2 {
+			continue
+		}
+		recipient, ok := args[0].(common.Address)
+		amount, ok2 := args[1].(*big.Int)
+		if !ok || !ok2 || recipient != p.Relayer {
+			continue
+		}
+		if amount.Cmp(fee) < 0 {
+			return nil, fmt.Errorf("reimbursement transfer of %s is below the quoted fee %s", amount, fee)
+		}
+		return op.Calls, nil
+	}
+
+	return nil, fmt.Errorf("user op batch does not include a transfer(relayer, >=%s) reimbursement call covered by the sender's signature", fee)
+}
+
+// SignedQuotePolicy accepts a UserOp that carries an unexpired Quote with a
+// positive MaxFee, without needing a live price oracle call on the hot path.
+// It does not verify Quote.Signature and does not compare any payment in
+// the batch against MaxFee.
+type SignedQuotePolicy struct {
+	Relayer common.Address
+}
+
+func (p SignedQuotePolicy) Apply(_ context.Context, op UserOp) ([]bsc.Call, error) {
+	if op.Quote == nil {
+		return nil, fmt.Errorf("signed quote policy requires a quote on the user op")
+	}
+	if time.Now().Unix() > op.Quote.ExpiresAt {
+		return nil, fmt.Errorf("quote expired at %d", op.Quote.ExpiresAt)
+	}
+	if op.Quote.MaxFee == nil || op.Quote.MaxFee.Sign() <= 0 {
+		return nil, fmt.Errorf("quote has no positive max fee")
+	}
+	// The batch is returned unchanged; any reimbursement call is left to the
+	// caller's batch so the user can pay in whatever asset (or off-chain
+	// invoice) the quote was denominated in.
+	return op.Calls, nil
+}
@@ -0,0 +1,240 @@
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bnb-chain/eip7702-demo/bsc"
+	"github.com/bnb-chain/eip7702-demo/compiler"
+	"github.com/bnb-chain/eip7702-demo/contracts/batcher"
+	"github.com/bnb-chain/eip7702-demo/contracts/bep20"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+// fixedOracle quotes a constant fee, for tests that don't need a live price
+// feed.
+type fixedOracle struct{ fee *big.Int }
+
+func (o fixedOracle) Quote(_ context.Context, _ uint64) (*big.Int, error) {
+	return o.fee, nil
+}
+
+// newTestSponsor compiles Batcher.sol, funds a sender account and installs
+// the compiled Batcher as the sender's own EIP-7702 delegate, so calls
+// packed for executeWithSig can be verified against the real contract.
+func newTestSponsor(t *testing.T) (backend *simulated.Backend, sender *bsc.Account, senderKey *ecdsa.PrivateKey) {
+	t.Helper()
+
+	contract, err := compiler.CompileSolidity("../contracts/batcher/Batcher.sol")
+	if err != nil {
+		t.Skipf("solc not available, skipping: %v", err)
+	}
+
+	deployerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate deployer key: %v", err)
+	}
+	senderKey, err = crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate sender key: %v", err)
+	}
+	deployerAddr := crypto.PubkeyToAddress(deployerKey.PublicKey)
+	senderAddr := crypto.PubkeyToAddress(senderKey.PublicKey)
+
+	balance := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000))
+	backend = simulated.NewBackend(types.GenesisAlloc{
+		deployerAddr: {Balance: balance},
+		senderAddr:   {Balance: balance},
+	})
+
+	deployer := bsc.NewAccountWithSigner(backend.Client(), bsc.NewECDSASigner(deployerKey))
+	sender = bsc.NewAccountWithSigner(backend.Client(), bsc.NewECDSASigner(senderKey))
+
+	deployOpts, err := deployer.BuildTransactOpts(0, nil, 3_000_000)
+	if err != nil {
+		t.Fatalf("build deploy opts: %v", err)
+	}
+	implAddr, _, err := deployer.DeployContract(deployOpts, contract)
+	if err != nil {
+		t.Fatalf("deploy batcher implementation: %v", err)
+	}
+	backend.Commit()
+
+	if _, err := bsc.NewDelegation(sender).Install(implAddr); err != nil {
+		t.Fatalf("install batcher delegate: %v", err)
+	}
+	backend.Commit()
+
+	return backend, sender, senderKey
+}
+
+// signExecuteWithSig signs calls exactly as Batcher.executeWithSig verifies
+// them: keccak256(abi.encode(chainId, address(this), nonce, calls)),
+// wrapped in the Ethereum signed message prefix.
+func signExecuteWithSig(t *testing.T, key *ecdsa.PrivateKey, chainID *big.Int, account common.Address, nonce *big.Int, calls []bsc.Call) []byte {
+	t.Helper()
+
+	uint256Ty, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		t.Fatalf("build uint256 type: %v", err)
+	}
+	addressTy, err := abi.NewType("address", "", nil)
+	if err != nil {
+		t.Fatalf("build address type: %v", err)
+	}
+	callsTy, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "target", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+	})
+	if err != nil {
+		t.Fatalf("build calls type: %v", err)
+	}
+	args := abi.Arguments{{Type: uint256Ty}, {Type: addressTy}, {Type: uint256Ty}, {Type: callsTy}}
+
+	preimage, err := args.Pack(chainID, account, nonce, calls)
+	if err != nil {
+		t.Fatalf("pack digest preimage: %v", err)
+	}
+	digest := crypto.Keccak256(preimage)
+	ethSignedDigest := crypto.Keccak256(append([]byte("\x19Ethereum Signed Message:\n32"), digest...))
+
+	sig, err := crypto.Sign(ethSignedDigest, key)
+	if err != nil {
+		t.Fatalf("sign digest: %v", err)
+	}
+	sig[64] += 27
+	return sig
+}
+
+// TestFeePoliciesProduceValidExecuteWithSigBatches signs a batch under each
+// FeePolicy's output and submits it to the real, deployed Batcher contract
+// via executeWithSig, so a policy that (like the old ERC20ReimbursementPolicy)
+// mutates the batch after the sender signed it fails loudly with
+// InvalidSignature instead of silently shipping a broken sponsorship path.
+func TestFeePoliciesProduceValidExecuteWithSigBatches(t *testing.T) {
+	backend, sender, senderKey := newTestSponsor(t)
+	defer backend.Close()
+
+	chainID, err := backend.Client().ChainID(context.Background())
+	if err != nil {
+		t.Fatalf("chain id: %v", err)
+	}
+
+	relayerKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate relayer key: %v", err)
+	}
+	relayerAddr := crypto.PubkeyToAddress(relayerKey.PublicKey)
+	tokenAddr := common.HexToAddress("0x00000000000000000000000000000000000face1")
+	dummyTarget := common.HexToAddress("0x00000000000000000000000000000000000bead1")
+
+	parsedBep20, err := abi.JSON(strings.NewReader(bep20.Bep20ABI))
+	if err != nil {
+		t.Fatalf("parse bep20 abi: %v", err)
+	}
+	fee := big.NewInt(1000)
+	transferData, err := parsedBep20.Pack("transfer", relayerAddr, fee)
+	if err != nil {
+		t.Fatalf("pack transfer: %v", err)
+	}
+
+	baseCalls := []bsc.Call{{Target: dummyTarget, Value: big.NewInt(0), Data: nil}}
+
+	cases := []struct {
+		name   string
+		policy FeePolicy
+		op     UserOp
+	}{
+		{
+			name:   "free",
+			policy: FreePolicy{},
+			op:     UserOp{Sender: *sender.Addr, Calls: baseCalls},
+		},
+		{
+			name: "erc20 reimbursement",
+			policy: ERC20ReimbursementPolicy{
+				Token:    tokenAddr,
+				Relayer:  relayerAddr,
+				Oracle:   fixedOracle{fee: fee},
+				GasLimit: 100_000,
+			},
+			op: UserOp{
+				Sender: *sender.Addr,
+				Calls:  append([]bsc.Call{{Target: tokenAddr, Value: big.NewInt(0), Data: transferData}}, baseCalls...),
+			},
+		},
+		{
+			name:   "signed quote",
+			policy: SignedQuotePolicy{Relayer: relayerAddr},
+			op: UserOp{
+				Sender: *sender.Addr,
+				Calls:  baseCalls,
+				Quote:  &SignedQuote{MaxFee: big.NewInt(1), ExpiresAt: time.Now().Add(time.Hour).Unix()},
+			},
+		},
+	}
+
+	batcherCaller, err := batcher.NewBatcher(*sender.Addr, backend.Client())
+	if err != nil {
+		t.Fatalf("bind batcher: %v", err)
+	}
+	relayerAccount := bsc.NewAccountWithSigner(backend.Client(), bsc.NewECDSASigner(relayerKey))
+
+	// The relayer pays gas for every executeWithSig transaction below but is
+	// not in the genesis alloc, so fund it from the sender first.
+	fundOpts, err := sender.BuildTransactOpts(0, nil, 21_000)
+	if err != nil {
+		t.Fatalf("build funding opts: %v", err)
+	}
+	if _, err := sender.SendEIP7702Tx(fundOpts, &relayerAddr, nil, big.NewInt(1e18), nil); err != nil {
+		t.Fatalf("fund relayer: %v", err)
+	}
+	backend.Commit()
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls, err := tc.policy.Apply(context.Background(), tc.op)
+			if err != nil {
+				t.Fatalf("apply policy: %v", err)
+			}
+
+			nonce, err := batcherCaller.Nonce(nil)
+			if err != nil {
+				t.Fatalf("read nonce: %v", err)
+			}
+			sig := signExecuteWithSig(t, senderKey, chainID, *sender.Addr, nonce, calls)
+
+			data, err := batcher.PackExecuteWithSig(calls, sig)
+			if err != nil {
+				t.Fatalf("pack execute with sig: %v", err)
+			}
+
+			opts, err := relayerAccount.BuildTransactOpts(0, nil, 3_000_000)
+			if err != nil {
+				t.Fatalf("build transact opts: %v", err)
+			}
+			txHash, err := relayerAccount.SendEIP7702Tx(opts, sender.Addr, nil, nil, data)
+			if err != nil {
+				t.Fatalf("send execute with sig tx: %v", err)
+			}
+			backend.Commit()
+
+			receipt, err := relayerAccount.Client.TransactionReceipt(context.Background(), *txHash)
+			if err != nil {
+				t.Fatalf("get receipt: %v", err)
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				t.Fatalf("executeWithSig reverted for policy %s: the packed calls are no longer covered by the sender's signature", tc.name)
+			}
+		})
+	}
+}
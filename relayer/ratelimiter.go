@@ -0,0 +1,65 @@
+package relayer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RateLimiter caps how often a single sender can submit UserOps, using a
+// token bucket per address so a burst is allowed but sustained spam is not.
+type RateLimiter struct {
+	mu      sync.Mutex
+	rate    float64 // tokens per second
+	burst   float64
+	buckets map[common.Address]*bucket
+	nowFunc func() time.Time
+}
+
+type bucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewRateLimiter allows up to burst UserOps immediately per sender,
+// refilling at rate tokens/second thereafter.
+func NewRateLimiter(rate, burst float64) *RateLimiter {
+	return &RateLimiter{
+		rate:    rate,
+		burst:   burst,
+		buckets: make(map[common.Address]*bucket),
+		nowFunc: time.Now,
+	}
+}
+
+// Allow reports whether sender may submit a UserOp right now, consuming one
+// token from its bucket if so.
+func (l *RateLimiter) Allow(sender common.Address) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.nowFunc()
+	b, ok := l.buckets[sender]
+	if !ok {
+		b = &bucket{tokens: l.burst, lastRefill: now}
+		l.buckets[sender] = b
+	}
+
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	b.tokens = minFloat(l.burst, b.tokens+elapsed*l.rate)
+	b.lastRefill = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
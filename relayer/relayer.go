@@ -0,0 +1,136 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bnb-chain/eip7702-demo/bsc"
+	"github.com/bnb-chain/eip7702-demo/contracts/batcher"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Relayer builds, signs and broadcasts the outer EIP-7702 transaction on
+// behalf of clients who only hold a signed SetCodeAuthorization (and
+// optionally a batch of calls to run through the resulting delegate) — the
+// productized version of the Joe-sponsors-Bob flow in the demo.
+type Relayer struct {
+	account  *bsc.Account
+	policy   FeePolicy
+	store    *ReceiptStore
+	limiter  *RateLimiter
+	gasLimit uint64
+}
+
+// NewRelayer builds a Relayer that pays gas from account, applying policy to
+// every UserOp and enforcing limiter per sender. store records outcomes so
+// clients can poll relay_getUserOpReceipt.
+func NewRelayer(account *bsc.Account, policy FeePolicy, store *ReceiptStore, limiter *RateLimiter, gasLimit uint64) *Relayer {
+	return &Relayer{
+		account:  account,
+		policy:   policy,
+		store:    store,
+		limiter:  limiter,
+		gasLimit: gasLimit,
+	}
+}
+
+// SponsorAuthorization broadcasts a SetCodeTx installing auth for sender,
+// mirroring the plain "Joe sends Bob's authorization" step in the demo.
+func (r *Relayer) SponsorAuthorization(ctx context.Context, auth types.SetCodeAuthorization, sender common.Address) (common.Hash, error) {
+	if !r.limiter.Allow(sender) {
+		return common.Hash{}, fmt.Errorf("rate limit exceeded for sender %s", sender.Hex())
+	}
+
+	opts, err := r.account.BuildTransactOpts(0, nil, r.gasLimit)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("build transaction options: %w", err)
+	}
+
+	txHash, err := r.account.SendEIP7702Tx(opts, &sender, []types.SetCodeAuthorization{auth}, nil, nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("send authorization tx: %w", err)
+	}
+	return *txHash, nil
+}
+
+// SendUserOp applies the relayer's fee policy to op, packs the resulting
+// calls for the sender's delegate, and broadcasts the outer transaction
+// (optionally alongside op.Authorization, if the sender is installing its
+// delegate in the same step). It returns immediately with the UserOp hash;
+// call GetUserOpReceipt to poll for the outcome.
+func (r *Relayer) SendUserOp(ctx context.Context, op UserOp) (common.Hash, error) {
+	if !r.limiter.Allow(op.Sender) {
+		return common.Hash{}, fmt.Errorf("rate limit exceeded for sender %s", op.Sender.Hex())
+	}
+
+	userOpHash := op.Hash()
+	r.store.Put(userOpHash, Receipt{Status: StatusPending})
+
+	if len(op.Signature) == 0 {
+		err := fmt.Errorf("user op has no signature: the relayer broadcasts on the sender's behalf, so the batch must go through the delegate's executeWithSig entrypoint")
+		r.store.Put(userOpHash, Receipt{Status: StatusFailed, Error: err.Error()})
+		return common.Hash{}, err
+	}
+
+	calls, err := r.policy.Apply(ctx, op)
+	if err != nil {
+		r.store.Put(userOpHash, Receipt{Status: StatusFailed, Error: err.Error()})
+		return common.Hash{}, fmt.Errorf("apply fee policy: %w", err)
+	}
+
+	// The relayer, not the sender, broadcasts this transaction, so msg.sender
+	// inside the delegate call is the relayer's address: route through
+	// executeWithSig (validated against op.Sender's signature) rather than
+	// the self-only execute() entrypoint.
+	data, err := batcher.PackExecuteWithSig(calls, op.Signature)
+	if err != nil {
+		r.store.Put(userOpHash, Receipt{Status: StatusFailed, Error: err.Error()})
+		return common.Hash{}, fmt.Errorf("pack batch: %w", err)
+	}
+
+	var authList []types.SetCodeAuthorization
+	if op.Authorization != nil {
+		authList = append(authList, *op.Authorization)
+	}
+
+	opts, err := r.account.BuildTransactOpts(0, nil, r.gasLimit)
+	if err != nil {
+		r.store.Put(userOpHash, Receipt{Status: StatusFailed, Error: err.Error()})
+		return common.Hash{}, fmt.Errorf("build transaction options: %w", err)
+	}
+
+	txHash, err := r.account.SendEIP7702Tx(opts, &op.Sender, authList, nil, data)
+	if err != nil {
+		r.store.Put(userOpHash, Receipt{Status: StatusFailed, Error: err.Error()})
+		return common.Hash{}, fmt.Errorf("send user op tx: %w", err)
+	}
+
+	r.store.Put(userOpHash, Receipt{Status: StatusSubmitted, TxHash: *txHash})
+	go r.awaitReceipt(userOpHash, *txHash)
+
+	return userOpHash, nil
+}
+
+// GetUserOpReceipt returns the last known status for a UserOp hash
+// previously returned by SendUserOp.
+func (r *Relayer) GetUserOpReceipt(ctx context.Context, userOpHash common.Hash) (Receipt, error) {
+	receipt, ok := r.store.Get(userOpHash)
+	if !ok {
+		return Receipt{}, fmt.Errorf("unknown user op %s", userOpHash.Hex())
+	}
+	return receipt, nil
+}
+
+func (r *Relayer) awaitReceipt(userOpHash, txHash common.Hash) {
+	receipt := r.account.GetReceipt(txHash, 120)
+	if receipt == nil {
+		r.store.Put(userOpHash, Receipt{Status: StatusFailed, TxHash: txHash, Error: "timed out waiting for receipt"})
+		return
+	}
+	if receipt.Status != 1 {
+		r.store.Put(userOpHash, Receipt{Status: StatusFailed, TxHash: txHash, Error: "transaction reverted"})
+		return
+	}
+	r.store.Put(userOpHash, Receipt{Status: StatusConfirmed, TxHash: txHash})
+}
@@ -0,0 +1,40 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// service is the JSON-RPC receiver registered under the "relay" namespace.
+// go-ethereum's rpc package derives method names from exported methods, so
+// SponsorAuthorization/SendUserOp/GetUserOpReceipt become
+// relay_sponsorAuthorization / relay_sendUserOp / relay_getUserOpReceipt.
+type service struct {
+	relayer *Relayer
+}
+
+func (s *service) SponsorAuthorization(ctx context.Context, auth types.SetCodeAuthorization, sender common.Address) (common.Hash, error) {
+	return s.relayer.SponsorAuthorization(ctx, auth, sender)
+}
+
+func (s *service) SendUserOp(ctx context.Context, op UserOp) (common.Hash, error) {
+	return s.relayer.SendUserOp(ctx, op)
+}
+
+func (s *service) GetUserOpReceipt(ctx context.Context, userOpHash common.Hash) (Receipt, error) {
+	return s.relayer.GetUserOpReceipt(ctx, userOpHash)
+}
+
+// NewHTTPHandler exposes relayer as a JSON-RPC 2.0 HTTP handler implementing
+// relay_sponsorAuthorization, relay_sendUserOp and relay_getUserOpReceipt.
+func NewHTTPHandler(relayer *Relayer) (http.Handler, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("relay", &service{relayer: relayer}); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
@@ -0,0 +1,35 @@
+package relayer
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ReceiptStore tracks the lifecycle of submitted UserOps, keyed by
+// UserOp.Hash(), so clients can poll relay_getUserOpReceipt instead of
+// watching the chain themselves.
+type ReceiptStore struct {
+	mu       sync.RWMutex
+	receipts map[common.Hash]Receipt
+}
+
+// NewReceiptStore returns an empty, ready-to-use ReceiptStore.
+func NewReceiptStore() *ReceiptStore {
+	return &ReceiptStore{receipts: make(map[common.Hash]Receipt)}
+}
+
+// Put records (or overwrites) the receipt for a user-op hash.
+func (s *ReceiptStore) Put(userOpHash common.Hash, r Receipt) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.receipts[userOpHash] = r
+}
+
+// Get returns the receipt for a user-op hash, if any has been recorded.
+func (s *ReceiptStore) Get(userOpHash common.Hash) (Receipt, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.receipts[userOpHash]
+	return r, ok
+}
@@ -0,0 +1,76 @@
+// Package relayer formalizes the "Joe sponsors Bob" pattern from the demo
+// into a standalone service: clients submit a signed EIP-7702 authorization
+// (plus an optional batch of calls), and the relayer builds, signs and
+// broadcasts the outer transaction on their behalf.
+package relayer
+
+import (
+	"math/big"
+
+	"github.com/bnb-chain/eip7702-demo/bsc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOp is what a client submits to relay_sendUserOp: a signed
+// authorization to install (or already installed) plus the batch of calls
+// to run through the resulting delegate.
+//
+// Because the relayer (not the sender) broadcasts the outer transaction,
+// msg.sender inside the delegate call is the relayer's address, not the
+// sender's — so the batch can never go through the delegate's self-only
+// execute() entrypoint. Signature must instead carry the sender's ECDSA
+// signature over keccak256(chainId, address(this), nonce, calls), as
+// defined by Batcher.executeWithSig, which the relayer submits on the
+// sender's behalf.
+type UserOp struct {
+	Sender        common.Address              `json:"sender"`
+	Authorization *types.SetCodeAuthorization `json:"authorization,omitempty"`
+	Calls         []bsc.Call                  `json:"calls"`
+	Signature     []byte                      `json:"signature"`
+	Quote         *SignedQuote                `json:"quote,omitempty"`
+}
+
+// Hash deterministically identifies a UserOp so a client can poll for its
+// outcome via the receipt store.
+func (op UserOp) Hash() common.Hash {
+	var buf []byte
+	buf = append(buf, op.Sender.Bytes()...)
+	for _, c := range op.Calls {
+		buf = append(buf, c.Target.Bytes()...)
+		if c.Value != nil {
+			buf = append(buf, c.Value.Bytes()...)
+		}
+		buf = append(buf, c.Data...)
+	}
+	buf = append(buf, op.Signature...)
+	return crypto.Keccak256Hash(buf, []byte("eip7702-userop"))
+}
+
+// SignedQuote is a user-signed max-fee quote, used by SignedQuotePolicy so
+// the relayer can charge up to an amount the user has already agreed to off
+// chain.
+type SignedQuote struct {
+	MaxFee    *big.Int `json:"maxFee"`
+	ExpiresAt int64    `json:"expiresAt"`
+	Signature []byte   `json:"signature"`
+}
+
+// Status is the lifecycle of a submitted UserOp as tracked by the receipt
+// store.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusSubmitted Status = "submitted"
+	StatusConfirmed Status = "confirmed"
+	StatusFailed    Status = "failed"
+)
+
+// Receipt is what clients get back from polling a UserOp's status.
+type Receipt struct {
+	Status Status      `json:"status"`
+	TxHash common.Hash `json:"txHash,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}